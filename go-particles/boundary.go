@@ -0,0 +1,36 @@
+package main
+
+import (
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// BoundarySystem bounces entities off the world edges with damping.
+type BoundarySystem struct {
+	Damping float64
+}
+
+// NewBoundarySystem creates a BoundarySystem with the simulation's
+// original damping constant.
+func NewBoundarySystem() *BoundarySystem {
+	return &BoundarySystem{Damping: 0.8}
+}
+
+func (s *BoundarySystem) Update(w *World, dt float64) {
+	for i := range w.Positions {
+		pos := &w.Positions[i]
+		vel := &w.Velocities[i]
+
+		if pos.X < 0 || pos.X > w.Width {
+			vel.VX *= -s.Damping
+			pos.X = math.Max(0, math.Min(w.Width, pos.X))
+		}
+		if pos.Y < 0 || pos.Y > w.Height {
+			vel.VY *= -s.Damping
+			pos.Y = math.Max(0, math.Min(w.Height, pos.Y))
+		}
+	}
+}
+
+func (s *BoundarySystem) Draw(w *World, screen *ebiten.Image) {}
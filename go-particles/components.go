@@ -0,0 +1,56 @@
+package main
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Position is the world-space location of an entity.
+type Position struct {
+	X, Y float64
+}
+
+// Velocity is the per-second rate of change of an entity's Position.
+type Velocity struct {
+	VX, VY float64
+}
+
+// Mass affects how strongly Force accumulates into Velocity.
+type Mass struct {
+	Value float64
+}
+
+// Color is the render color of an entity drawn by RenderSystem.
+type Color struct {
+	color.RGBA
+}
+
+// Force accumulates the net force acting on an entity for the current
+// tick; ForceSystem writes it, MovementSystem consumes and resets it.
+type Force struct {
+	FX, FY float64
+}
+
+// Lifetime is an optional component: entities that carry one are retired
+// by LifetimeSystem once Life reaches zero.
+type Lifetime struct {
+	Life    float64
+	MaxLife float64
+}
+
+// Sprite is an optional component for entities rendered via DrawImage
+// instead of a plain colored rect. Scale and Alpha are written each tick
+// by LifetimeSystem from ScaleOverLife/AlphaOverLife, when set.
+type Sprite struct {
+	Image    *ebiten.Image
+	Rotation float64
+	Scale    float64
+	Alpha    float64
+
+	// ScaleOverLife and AlphaOverLife map a particle's age, t in [0,1]
+	// from birth to death, to its Scale/Alpha for that tick. Either may
+	// be nil to leave Scale/Alpha constant.
+	ScaleOverLife func(t float64) float64
+	AlphaOverLife func(t float64) float64
+}
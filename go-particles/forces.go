@@ -0,0 +1,112 @@
+package main
+
+import (
+	"sync"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// ForceFunc computes the pairwise force entity b exerts on entity a, e.g.
+// gravitational attraction, Lennard-Jones repulsion, or a Coulomb kernel.
+// Returning (0, 0) means no interaction between the pair.
+type ForceFunc func(aPos Position, aMass Mass, bPos Position, bMass Mass) (fx, fy float64)
+
+// ForceSystem sums ForceFunc over nearby entities using a spatial hash so
+// that the cost stays proportional to local density instead of N^2. Work
+// is partitioned by cell range: each worker owns a disjoint set of cells
+// and only writes to the Force of entities inside them, which preserves
+// cache locality and avoids write contention between workers.
+type ForceSystem struct {
+	MaxInteractionRadius float64
+	ForceFunc            ForceFunc
+
+	hash *spatialHash
+}
+
+// NewForceSystem creates a ForceSystem. maxRadius sizes both the
+// interaction cutoff and the spatial hash cells (~2x maxRadius).
+func NewForceSystem(maxRadius float64, fn ForceFunc) *ForceSystem {
+	return &ForceSystem{
+		MaxInteractionRadius: maxRadius,
+		ForceFunc:            fn,
+		hash:                 newSpatialHash(2 * maxRadius),
+	}
+}
+
+func (s *ForceSystem) Update(w *World, dt float64) {
+	if s.ForceFunc == nil {
+		return
+	}
+
+	s.hash.rebuild(w.Positions)
+
+	// Pre-allocate every Force slot serially so the parallel pass below
+	// only ever mutates existing pointees, never the map itself.
+	for i := range w.Positions {
+		e := Entity(i)
+		if w.Forces[e] == nil {
+			w.Forces[e] = &Force{}
+		}
+	}
+
+	cells := s.hash.cellKeys()
+	if len(cells) == 0 {
+		return
+	}
+
+	numWorkers := len(cells)
+	if numWorkers > 8 {
+		numWorkers = 8
+	}
+	cellsPerWorker := (len(cells) + numWorkers - 1) / numWorkers
+
+	var wg sync.WaitGroup
+	for wi := 0; wi < numWorkers; wi++ {
+		start := wi * cellsPerWorker
+		if start >= len(cells) {
+			break
+		}
+		end := start + cellsPerWorker
+		if end > len(cells) {
+			end = len(cells)
+		}
+
+		wg.Add(1)
+		go func(owned []cellCoord) {
+			defer wg.Done()
+			for _, c := range owned {
+				for _, idx := range s.hash.cells[c] {
+					s.accumulate(w, c, Entity(idx))
+				}
+			}
+		}(cells[start:end])
+	}
+	wg.Wait()
+}
+
+// accumulate sums ForceFunc across the 9-cell neighborhood of e into its
+// Force component.
+func (s *ForceSystem) accumulate(w *World, cell cellCoord, e Entity) {
+	pos := w.Positions[e]
+	var fx, fy float64
+
+	for _, j := range s.hash.neighbors(cell) {
+		if Entity(j) == e {
+			continue
+		}
+		other := w.Positions[j]
+		dx := other.X - pos.X
+		dy := other.Y - pos.Y
+		if dx*dx+dy*dy > s.MaxInteractionRadius*s.MaxInteractionRadius {
+			continue
+		}
+		ffx, ffy := s.ForceFunc(pos, w.Masses[e], other, w.Masses[j])
+		fx += ffx
+		fy += ffy
+	}
+
+	f := w.Forces[e]
+	f.FX, f.FY = fx, fy
+}
+
+func (s *ForceSystem) Draw(w *World, screen *ebiten.Image) {}
@@ -0,0 +1,147 @@
+package main
+
+import "time"
+
+// historyEpsilon is how far a component must move between recorded
+// ticks before it's worth storing; entities that haven't moved beyond
+// this are simply omitted from the snapshot.
+const historyEpsilon = 0.01
+
+// historyDelta is a delta-compressed Position+Velocity, stored as
+// float32 to keep memory bounded for 10k+ particles.
+type historyDelta struct {
+	X, Y, VX, VY float32
+}
+
+// historySnapshot records, for a single tick, every entity whose
+// historyDelta moved beyond historyEpsilon since the previous snapshot.
+type historySnapshot struct {
+	tick    int
+	changed map[Entity]historyDelta
+}
+
+// History is a fixed-size ring buffer of historySnapshot used to scrub a
+// World backward in time, e.g. to step back and inspect a collision or
+// to record a demo.
+type History struct {
+	recordEvery int     // ticks between snapshots
+	dt          float64 // seconds per tick, for converting a Duration to a tick count
+
+	snapshots []historySnapshot
+	prev      []historyDelta
+	tick      int
+	next      int
+	filled    bool
+
+	// stale marks entity slots whose index was just reused by
+	// World.RemoveEntity's swap-and-shrink or by a new NewEntity, so the
+	// next Record captures them unconditionally instead of comparing
+	// against another entity's leftover prev delta.
+	stale map[Entity]bool
+}
+
+// NewHistory creates a ring buffer covering the last window of
+// wall-clock time, snapshotting every recordEvery ticks. dt is the
+// world's fixed tick duration.
+func NewHistory(window time.Duration, recordEvery int, dt float64) *History {
+	capacity := int(window.Seconds()/dt) / recordEvery
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &History{
+		recordEvery: recordEvery,
+		dt:          dt,
+		snapshots:   make([]historySnapshot, capacity),
+	}
+}
+
+// Record should be called once per tick; it only actually snapshots
+// every recordEvery ticks.
+func (h *History) Record(w *World) {
+	h.tick++
+	if h.tick%h.recordEvery != 0 {
+		return
+	}
+
+	for len(h.prev) < len(w.Positions) {
+		h.prev = append(h.prev, historyDelta{})
+	}
+
+	changed := make(map[Entity]historyDelta)
+	for i, pos := range w.Positions {
+		e := Entity(i)
+		vel := w.Velocities[i]
+		cur := historyDelta{X: float32(pos.X), Y: float32(pos.Y), VX: float32(vel.VX), VY: float32(vel.VY)}
+		last := h.prev[i]
+		if h.stale[e] || abs32(cur.X-last.X) > historyEpsilon || abs32(cur.Y-last.Y) > historyEpsilon ||
+			abs32(cur.VX-last.VX) > historyEpsilon || abs32(cur.VY-last.VY) > historyEpsilon {
+			changed[e] = cur
+			h.prev[i] = cur
+		}
+	}
+	h.stale = nil
+
+	h.snapshots[h.next] = historySnapshot{tick: h.tick, changed: changed}
+	h.next = (h.next + 1) % len(h.snapshots)
+	if h.next == 0 {
+		h.filled = true
+	}
+}
+
+// invalidate forces the next Record to capture e unconditionally,
+// regardless of historyEpsilon, because its index was just reused for a
+// different logical entity.
+func (h *History) invalidate(e Entity) {
+	if h.stale == nil {
+		h.stale = make(map[Entity]bool)
+	}
+	h.stale[e] = true
+}
+
+// chronological returns the surviving snapshots oldest-first.
+func (h *History) chronological() []historySnapshot {
+	if !h.filled {
+		return h.snapshots[:h.next]
+	}
+	out := make([]historySnapshot, 0, len(h.snapshots))
+	out = append(out, h.snapshots[h.next:]...)
+	out = append(out, h.snapshots[:h.next]...)
+	return out
+}
+
+// Rewind reconstructs w's Position/Velocity state as of d ago by
+// replaying every surviving snapshot at or before that tick, oldest
+// first, so each entity ends at its last recorded value no later than
+// the target. Callers should set World.Paused while scrubbing so forward
+// integration doesn't immediately overwrite the rewound state.
+//
+// Entities spawned or retired don't leave a tombstone in the recorded
+// deltas, so scrubbing past their spawn/death just shows them at
+// whatever live position they currently hold rather than absent — an
+// accepted tradeoff for keeping snapshots cheap to store and replay.
+func (h *History) Rewind(w *World, d time.Duration) {
+	target := h.tick - int(d.Seconds()/h.dt)
+	if target < 0 {
+		target = 0
+	}
+
+	for _, snap := range h.chronological() {
+		if snap.tick > target {
+			break
+		}
+		for e, delta := range snap.changed {
+			if int(e) >= len(w.Positions) {
+				continue
+			}
+			w.Positions[e] = Position{X: float64(delta.X), Y: float64(delta.Y)}
+			w.Velocities[e] = Velocity{VX: float64(delta.VX), VY: float64(delta.VY)}
+		}
+	}
+}
+
+func abs32(v float32) float32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
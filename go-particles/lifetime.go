@@ -0,0 +1,94 @@
+package main
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// LifetimeSystem ages every entity that carries a Lifetime, drives its
+// Sprite's scale/alpha curves (if any), and compacts dead slots once
+// Life reaches zero.
+type LifetimeSystem struct{}
+
+// NewLifetimeSystem creates a LifetimeSystem.
+func NewLifetimeSystem() *LifetimeSystem {
+	return &LifetimeSystem{}
+}
+
+// Update decrements every Lifetime in parallel: the sparse Lifetimes map
+// is snapshotted into a dense key slice once, then partitioned across
+// workers like ForceSystem's cell ranges. Each worker only ever mutates
+// the *Lifetime/*Sprite pointees behind its own disjoint keys, never the
+// maps themselves, so no synchronization is needed until the dead list
+// is merged and compacted after every worker finishes.
+func (s *LifetimeSystem) Update(w *World, dt float64) {
+	keys := make([]Entity, 0, len(w.Lifetimes))
+	for e := range w.Lifetimes {
+		keys = append(keys, e)
+	}
+	if len(keys) == 0 {
+		return
+	}
+
+	numWorkers := runtime.NumCPU()
+	if numWorkers > len(keys) {
+		numWorkers = len(keys)
+	}
+	perWorker := (len(keys) + numWorkers - 1) / numWorkers
+	deadPerWorker := make([][]Entity, numWorkers)
+
+	var wg sync.WaitGroup
+	for wi := 0; wi < numWorkers; wi++ {
+		start := wi * perWorker
+		if start >= len(keys) {
+			break
+		}
+		end := start + perWorker
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		wg.Add(1)
+		go func(wi int, owned []Entity) {
+			defer wg.Done()
+			deadPerWorker[wi] = s.ageRange(w, owned, dt)
+		}(wi, keys[start:end])
+	}
+	wg.Wait()
+
+	var dead []Entity
+	for _, d := range deadPerWorker {
+		dead = append(dead, d...)
+	}
+
+	w.RemoveEntities(dead)
+}
+
+// ageRange decrements Life and drives Sprite curves for the given
+// entities, returning the ones that expired.
+func (s *LifetimeSystem) ageRange(w *World, entities []Entity, dt float64) []Entity {
+	var dead []Entity
+	for _, e := range entities {
+		lt := w.Lifetimes[e]
+		lt.Life -= dt
+		if lt.Life <= 0 {
+			dead = append(dead, e)
+			continue
+		}
+
+		if sp, ok := w.Sprites[e]; ok {
+			t := 1 - lt.Life/lt.MaxLife
+			if sp.ScaleOverLife != nil {
+				sp.Scale = sp.ScaleOverLife(t)
+			}
+			if sp.AlphaOverLife != nil {
+				sp.Alpha = sp.AlphaOverLife(t)
+			}
+		}
+	}
+	return dead
+}
+
+func (s *LifetimeSystem) Draw(w *World, screen *ebiten.Image) {}
@@ -4,123 +4,19 @@ import (
 	"fmt"
 	"image/color"
 	"math"
-	"math/rand"
-	"runtime"
-	"sync"
+	"math/rand/v2"
 	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
 )
 
-// Particle represents a single particle in the simulation
-type Particle struct {
-	X, Y     float64 // Position
-	VX, VY   float64 // Velocity
-	Mass     float64
-	Color    color.RGBA
-}
-
-// Simulation holds the state of the particle simulation
-type Simulation struct {
-	Particles []Particle
-	Width     float64
-	Height    float64
-	DeltaTime float64
-}
-
-// NewSimulation creates a new particle simulation
-func NewSimulation(width, height float64, numParticles int) *Simulation {
-	sim := &Simulation{
-		Width:     width,
-		Height:    height,
-		DeltaTime: 1.0 / 60.0, // 60 FPS
-		Particles: make([]Particle, numParticles),
-	}
-
-	// Initialize particles with random positions and velocities
-	for i := range sim.Particles {
-		sim.Particles[i] = Particle{
-			X:     rand.Float64() * width,
-			Y:     rand.Float64() * height,
-			VX:    (rand.Float64() - 0.5) * 100,
-			VY:    (rand.Float64() - 0.5) * 100,
-			Mass:  1.0,
-			Color: color.RGBA{
-				R: uint8(rand.Float64() * 255),
-				G: uint8(rand.Float64() * 255),
-				B: uint8(rand.Float64() * 255),
-				A: 255,
-			},
-		}
-	}
-
-	return sim
-}
-
-// Update updates the simulation using parallel processing
-func (s *Simulation) Update() {
-	numWorkers := runtime.NumCPU()
-	particlesPerWorker := len(s.Particles) / numWorkers
-
-	var wg sync.WaitGroup
-	wg.Add(numWorkers)
-
-	for i := 0; i < numWorkers; i++ {
-		start := i * particlesPerWorker
-		end := start + particlesPerWorker
-		if i == numWorkers-1 {
-			end = len(s.Particles)
-		}
-
-		go func(start, end int) {
-			defer wg.Done()
-			s.updateParticlesRange(start, end)
-		}(start, end)
-	}
-
-	wg.Wait()
-}
-
-// updateParticlesRange updates a range of particles
-func (s *Simulation) updateParticlesRange(start, end int) {
-	for i := start; i < end; i++ {
-		s.updateParticle(i)
-	}
-}
-
-// updateParticle updates a single particle
-func (s *Simulation) updateParticle(index int) {
-	particle := &s.Particles[index]
-
-	// Apply gravity
-	particle.VY -= 50 * s.DeltaTime
-
-	// Update position
-	particle.X += particle.VX * s.DeltaTime
-	particle.Y += particle.VY * s.DeltaTime
-
-	// Boundary collision with damping
-	if particle.X < 0 || particle.X > s.Width {
-		particle.VX *= -0.8
-		particle.X = math.Max(0, math.Min(s.Width, particle.X))
-	}
-	if particle.Y < 0 || particle.Y > s.Height {
-		particle.VY *= -0.8
-		particle.Y = math.Max(0, math.Min(s.Height, particle.Y))
-	}
-
-	// Add some air resistance
-	particle.VX *= 0.999
-	particle.VY *= 0.999
-}
-
 // Game represents the Ebiten game
 type Game struct {
-	simulation *Simulation
+	world      *World
 	lastTime   time.Time
 	frames     int
 	lastFPS    time.Time
+	rewindHeld time.Duration
 }
 
 // Update updates the game state
@@ -128,8 +24,19 @@ func (g *Game) Update() error {
 	now := time.Now()
 	g.lastTime = now
 
-	// Update simulation
-	g.simulation.Update()
+	// Holding the left arrow scrubs backward through recorded History;
+	// releasing it resumes forward integration from wherever it lands.
+	if g.world.History != nil && ebiten.IsKeyPressed(ebiten.KeyLeft) {
+		g.rewindHeld += time.Duration(g.world.DeltaTime * float64(time.Second))
+		g.world.Paused = true
+		g.world.History.Rewind(g.world, g.rewindHeld)
+	} else {
+		g.rewindHeld = 0
+		g.world.Paused = false
+	}
+
+	// Advance every registered system
+	g.world.Update(g.world.DeltaTime)
 
 	// Update FPS counter
 	g.frames++
@@ -144,14 +51,8 @@ func (g *Game) Update() error {
 
 // Draw draws the game
 func (g *Game) Draw(screen *ebiten.Image) {
-	// Clear screen
 	screen.Fill(color.RGBA{0, 0, 0, 255})
-
-	// Draw particles
-	for _, particle := range g.simulation.Particles {
-		// Draw a small circle for each particle
-		ebitenutil.DrawRect(screen, particle.X-1, particle.Y-1, 2, 2, particle.Color)
-	}
+	g.world.Draw(screen)
 }
 
 // Layout returns the game layout
@@ -159,14 +60,69 @@ func (g *Game) Layout(outsideWidth, outsideHeight int) (screenWidth, screenHeigh
 	return 800, 600
 }
 
+// spawnRandomParticles populates the world with n entities at random
+// positions, velocities and colors.
+func spawnRandomParticles(w *World, rng *rand.Rand, n int) {
+	for i := 0; i < n; i++ {
+		w.NewEntity(
+			Position{X: rng.Float64() * w.Width, Y: rng.Float64() * w.Height},
+			Velocity{VX: (rng.Float64() - 0.5) * 100, VY: (rng.Float64() - 0.5) * 100},
+			Mass{Value: 1.0},
+			Color{color.RGBA{
+				R: uint8(rng.Float64() * 255),
+				G: uint8(rng.Float64() * 255),
+				B: uint8(rng.Float64() * 255),
+				A: 255,
+			}},
+		)
+	}
+}
+
+// newSmokeTexture procedurally builds a small soft-edged circle used as
+// the smoke emitter's sprite.
+func newSmokeTexture() *ebiten.Image {
+	const size = 16
+	img := ebiten.NewImage(size, size)
+	center := float64(size) / 2
+
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			dx := float64(x) + 0.5 - center
+			dy := float64(y) + 0.5 - center
+			alpha := 1 - math.Sqrt(dx*dx+dy*dy)/center
+			if alpha < 0 {
+				alpha = 0
+			}
+			img.Set(x, y, color.RGBA{200, 200, 200, uint8(alpha * 255)})
+		}
+	}
+
+	return img
+}
+
 func main() {
-	// Create simulation with 1000 particles
-	sim := NewSimulation(800, 600, 1000)
+	rng := rand.New(rand.NewPCG(1, 2))
+
+	world := NewWorld(800, 600)
+	spawnRandomParticles(world, rng, 1000)
+
+	smoke := NewEmitter(rng, 400, 580)
+	smoke.Image = newSmokeTexture()
+
+	world.Systems = []System{
+		NewEmitterSystem(smoke),
+		NewForceSystem(20, nil),
+		NewMovementSystem(),
+		NewBoundarySystem(),
+		NewLifetimeSystem(),
+		NewRenderSystem(),
+	}
+	world.History = NewHistory(10*time.Second, 6, world.DeltaTime)
 
 	game := &Game{
-		simulation: sim,
-		lastTime:   time.Now(),
-		lastFPS:    time.Now(),
+		world:    world,
+		lastTime: time.Now(),
+		lastFPS:  time.Now(),
 	}
 
 	ebiten.SetWindowTitle("Go Particle Simulation - Parallel Processing")
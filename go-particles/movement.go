@@ -0,0 +1,43 @@
+package main
+
+import "github.com/hajimehoshi/ebiten/v2"
+
+// MovementSystem integrates Force and a constant downward Gravity into
+// Velocity, then Velocity into Position, applying a small amount of air
+// resistance each tick.
+type MovementSystem struct {
+	Gravity       float64
+	AirResistance float64
+}
+
+// NewMovementSystem creates a MovementSystem with the simulation's
+// original gravity and air resistance constants.
+func NewMovementSystem() *MovementSystem {
+	return &MovementSystem{
+		Gravity:       50,
+		AirResistance: 0.999,
+	}
+}
+
+func (s *MovementSystem) Update(w *World, dt float64) {
+	for i := range w.Positions {
+		vel := &w.Velocities[i]
+
+		if f := w.Forces[Entity(i)]; f != nil {
+			mass := w.Masses[i].Value
+			vel.VX += f.FX / mass * dt
+			vel.VY += f.FY / mass * dt
+		}
+
+		vel.VY -= s.Gravity * dt
+
+		pos := &w.Positions[i]
+		pos.X += vel.VX * dt
+		pos.Y += vel.VY * dt
+
+		vel.VX *= s.AirResistance
+		vel.VY *= s.AirResistance
+	}
+}
+
+func (s *MovementSystem) Draw(w *World, screen *ebiten.Image) {}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+	"github.com/jakecoffman/cp"
+)
+
+// PhysicsSystem steps a Chipmunk2D cp.Space each tick instead of the
+// manual gravity/damping/boundary integration, giving particles proper
+// circular rigid-body collision response. UsePhysics installs it in
+// place of ForceSystem, MovementSystem and BoundarySystem.
+type PhysicsSystem struct {
+	Space *cp.Space
+
+	// Debug, when > 0, outlines every body/shape in Space each frame,
+	// in the spirit of doctorlectro's RenderDebugTextSystem.
+	Debug int
+
+	// radius is reused for every entity added after construction, via
+	// onEntityCreated, so bodies spawned mid-simulation match the ones
+	// NewPhysicsSystem seeded up front.
+	radius float64
+
+	bodies map[Entity]*cp.Body
+}
+
+// NewPhysicsSystem wires a cp.Space with static boundary segments along
+// the world edges and mirrors every existing entity to a
+// cp.Body+cp.CircleShape of the given radius.
+func NewPhysicsSystem(w *World, space *cp.Space, radius float64) *PhysicsSystem {
+	s := &PhysicsSystem{
+		Space:  space,
+		radius: radius,
+		bodies: make(map[Entity]*cp.Body),
+	}
+
+	addBoundarySegment(space, cp.Vector{X: 0, Y: 0}, cp.Vector{X: w.Width, Y: 0})
+	addBoundarySegment(space, cp.Vector{X: w.Width, Y: 0}, cp.Vector{X: w.Width, Y: w.Height})
+	addBoundarySegment(space, cp.Vector{X: w.Width, Y: w.Height}, cp.Vector{X: 0, Y: w.Height})
+	addBoundarySegment(space, cp.Vector{X: 0, Y: w.Height}, cp.Vector{X: 0, Y: 0})
+
+	for i := range w.Positions {
+		s.addBody(w, Entity(i), radius)
+	}
+
+	return s
+}
+
+// addBoundarySegment adds a static, bouncy wall to space.
+func addBoundarySegment(space *cp.Space, a, b cp.Vector) {
+	seg := cp.NewSegment(space.StaticBody, a, b, 0)
+	seg.SetElasticity(0.8)
+	seg.SetFriction(0.5)
+	space.AddShape(seg)
+}
+
+// addBody mirrors entity e's Position/Velocity/Mass into a new rigid
+// body and tracks it for Update to read back from each tick.
+func (s *PhysicsSystem) addBody(w *World, e Entity, radius float64) {
+	pos := w.Positions[e]
+	vel := w.Velocities[e]
+	mass := w.Masses[e].Value
+
+	body := cp.NewBody(mass, cp.MomentForCircle(mass, 0, radius, cp.Vector{}))
+	body.SetPosition(cp.Vector{X: pos.X, Y: pos.Y})
+	body.SetVelocityVector(cp.Vector{X: vel.VX, Y: vel.VY})
+	s.Space.AddBody(body)
+
+	shape := cp.NewCircle(body, radius, cp.Vector{})
+	shape.SetElasticity(0.8)
+	shape.SetFriction(0.5)
+	s.Space.AddShape(shape)
+
+	s.bodies[e] = body
+}
+
+// onEntityCreated mirrors a newly created entity into the physics space,
+// the same way NewPhysicsSystem seeds entities that existed up front.
+func (s *PhysicsSystem) onEntityCreated(w *World, e Entity) {
+	s.addBody(w, e, s.radius)
+}
+
+// onEntityRemoved takes e's body and shapes out of Space and drops them
+// from bodies. e is the logical entity World.RemoveEntity is actually
+// retiring, before any swap-with-last renumbering, so this must run
+// before bodies is re-keyed by onEntityMoved.
+func (s *PhysicsSystem) onEntityRemoved(e Entity) {
+	body, ok := s.bodies[e]
+	if !ok {
+		return
+	}
+	body.EachShape(func(shape *cp.Shape) {
+		s.Space.RemoveShape(shape)
+	})
+	s.Space.RemoveBody(body)
+	delete(s.bodies, e)
+}
+
+// onEntityMoved re-keys the surviving body that RemoveEntity's
+// swap-with-last just relocated from "from" to "to", mirroring how the
+// same swap moves Lifetimes/Sprites/Forces.
+func (s *PhysicsSystem) onEntityMoved(from, to Entity) {
+	if body, ok := s.bodies[from]; ok {
+		s.bodies[to] = body
+	} else {
+		delete(s.bodies, to)
+	}
+	delete(s.bodies, from)
+}
+
+// Update steps the Chipmunk space and reads each body's position and
+// velocity back into its Position/Velocity components for rendering.
+func (s *PhysicsSystem) Update(w *World, dt float64) {
+	s.Space.Step(dt)
+
+	for e, body := range s.bodies {
+		pos := body.Position()
+		vel := body.Velocity()
+		w.Positions[e] = Position{X: pos.X, Y: pos.Y}
+		w.Velocities[e] = Velocity{VX: vel.X, VY: vel.Y}
+	}
+}
+
+// Draw outlines every body's shapes in the space when Debug > 0.
+func (s *PhysicsSystem) Draw(w *World, screen *ebiten.Image) {
+	if s.Debug <= 0 {
+		return
+	}
+
+	s.Space.EachBody(func(body *cp.Body) {
+		body.EachShape(func(shape *cp.Shape) {
+			circle, ok := shape.Class.(*cp.Circle)
+			if !ok {
+				return
+			}
+			center := body.Position()
+			vector.StrokeCircle(screen, float32(center.X), float32(center.Y), float32(circle.Radius()), 1, color.RGBA{0, 255, 0, 255}, false)
+		})
+	})
+}
+
+// UsePhysics switches w from the manual gravity/damping/boundary
+// integration to a Chipmunk2D-backed rigid-body simulation: ForceSystem,
+// MovementSystem and BoundarySystem are replaced by a single
+// PhysicsSystem that steps space each tick and writes positions back for
+// RenderSystem.
+func (w *World) UsePhysics(space *cp.Space, radius float64) *PhysicsSystem {
+	phys := NewPhysicsSystem(w, space, radius)
+
+	kept := w.Systems[:0]
+	for _, sys := range w.Systems {
+		switch sys.(type) {
+		case *ForceSystem, *MovementSystem, *BoundarySystem:
+			continue
+		default:
+			kept = append(kept, sys)
+		}
+	}
+
+	w.Systems = append([]System{phys}, kept...)
+	w.Physics = phys
+	return phys
+}
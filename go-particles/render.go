@@ -0,0 +1,54 @@
+package main
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+// RenderSystem draws every entity: a textured sprite via DrawImage for
+// entities carrying a Sprite with an Image, otherwise a small colored
+// rect.
+type RenderSystem struct{}
+
+// NewRenderSystem creates a RenderSystem.
+func NewRenderSystem() *RenderSystem {
+	return &RenderSystem{}
+}
+
+func (s *RenderSystem) Update(w *World, dt float64) {}
+
+func (s *RenderSystem) Draw(w *World, screen *ebiten.Image) {
+	for i, pos := range w.Positions {
+		e := Entity(i)
+		if sp, ok := w.Sprites[e]; ok && sp.Image != nil {
+			drawSprite(screen, pos, sp)
+			continue
+		}
+
+		col := w.Colors[i]
+		ebitenutil.DrawRect(screen, pos.X-1, pos.Y-1, 2, 2, col.RGBA)
+	}
+}
+
+// drawSprite renders a single textured particle, centering the image on
+// its Position and applying its rotation, scale-over-life and
+// alpha-over-life.
+func drawSprite(screen *ebiten.Image, pos Position, sp *Sprite) {
+	bounds := sp.Image.Bounds()
+	halfW := float64(bounds.Dx()) / 2
+	halfH := float64(bounds.Dy()) / 2
+
+	scale := sp.Scale
+	if scale == 0 {
+		scale = 1
+	}
+
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(-halfW, -halfH)
+	op.GeoM.Rotate(sp.Rotation)
+	op.GeoM.Scale(scale, scale)
+	op.GeoM.Translate(pos.X, pos.Y)
+	op.ColorScale.ScaleAlpha(float32(sp.Alpha))
+
+	screen.DrawImage(sp.Image, op)
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"runtime"
+	"sync"
+)
+
+// cellCoord identifies a single cell in the spatial hash grid.
+type cellCoord struct {
+	X, Y int
+}
+
+// spatialHash partitions entities into a uniform grid of cells so that
+// force calculations only need to consider nearby entities instead of
+// every other entity in the world.
+type spatialHash struct {
+	cellSize float64
+	cells    map[cellCoord][]int
+}
+
+// newSpatialHash creates a spatial hash with the given cell size. cellSize
+// should be roughly 2x the largest interaction radius so that any pair of
+// particles closer than that radius fall within the 9-cell neighborhood.
+func newSpatialHash(cellSize float64) *spatialHash {
+	return &spatialHash{
+		cellSize: cellSize,
+		cells:    make(map[cellCoord][]int),
+	}
+}
+
+// coordFor returns the cell a position falls into.
+func (h *spatialHash) coordFor(x, y float64) cellCoord {
+	return cellCoord{
+		X: int(x / h.cellSize),
+		Y: int(y / h.cellSize),
+	}
+}
+
+// rebuild repartitions entities into cells from their Position component.
+// The bucketing is done by sharding the position slice across goroutines
+// and merging the resulting partial maps, so the cost of rebuilding each
+// tick stays parallel even though the final map itself is shared.
+func (h *spatialHash) rebuild(positions []Position) {
+	for k := range h.cells {
+		delete(h.cells, k)
+	}
+
+	numWorkers := runtime.NumCPU()
+	if numWorkers > len(positions) {
+		numWorkers = len(positions)
+	}
+	if numWorkers <= 0 {
+		return
+	}
+
+	perWorker := len(positions) / numWorkers
+	partials := make([]map[cellCoord][]int, numWorkers)
+
+	var wg sync.WaitGroup
+	wg.Add(numWorkers)
+	for w := 0; w < numWorkers; w++ {
+		start := w * perWorker
+		end := start + perWorker
+		if w == numWorkers-1 {
+			end = len(positions)
+		}
+
+		go func(w, start, end int) {
+			defer wg.Done()
+			local := make(map[cellCoord][]int)
+			for i := start; i < end; i++ {
+				c := h.coordFor(positions[i].X, positions[i].Y)
+				local[c] = append(local[c], i)
+			}
+			partials[w] = local
+		}(w, start, end)
+	}
+	wg.Wait()
+
+	for _, local := range partials {
+		for c, idxs := range local {
+			h.cells[c] = append(h.cells[c], idxs...)
+		}
+	}
+}
+
+// cellKeys returns the occupied cells, used to partition work across
+// workers by cell rather than by flat entity index.
+func (h *spatialHash) cellKeys() []cellCoord {
+	keys := make([]cellCoord, 0, len(h.cells))
+	for c := range h.cells {
+		keys = append(keys, c)
+	}
+	return keys
+}
+
+// neighbors returns the indices of all particles in the 3x3 block of cells
+// centered on c (including c itself).
+func (h *spatialHash) neighbors(c cellCoord) []int {
+	var out []int
+	for dx := -1; dx <= 1; dx++ {
+		for dy := -1; dy <= 1; dy++ {
+			out = append(out, h.cells[cellCoord{X: c.X + dx, Y: c.Y + dy}]...)
+		}
+	}
+	return out
+}
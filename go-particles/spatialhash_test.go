@@ -0,0 +1,69 @@
+package main
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestSpatialHashCoordFor(t *testing.T) {
+	h := newSpatialHash(10)
+
+	tests := []struct {
+		name string
+		x, y float64
+		want cellCoord
+	}{
+		{"origin", 0, 0, cellCoord{X: 0, Y: 0}},
+		{"within first cell", 9.9, 9.9, cellCoord{X: 0, Y: 0}},
+		{"exact cell boundary", 10, 10, cellCoord{X: 1, Y: 1}},
+		{"second cell", 15, 25, cellCoord{X: 1, Y: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := h.coordFor(tt.x, tt.y); got != tt.want {
+				t.Errorf("coordFor(%v, %v) = %v, want %v", tt.x, tt.y, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpatialHashRebuildAndNeighbors(t *testing.T) {
+	positions := []Position{
+		{X: 1, Y: 1},   // cell (0,0)
+		{X: 11, Y: 1},  // cell (1,0), adjacent to (0,0)
+		{X: 1, Y: 11},  // cell (0,1), adjacent to (0,0)
+		{X: 21, Y: 21}, // cell (2,2), not adjacent to (0,0)
+	}
+
+	h := newSpatialHash(10)
+	h.rebuild(positions)
+
+	got := h.neighbors(cellCoord{X: 0, Y: 0})
+	sort.Ints(got)
+	want := []int{0, 1, 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("neighbors(0,0) = %v, want %v", got, want)
+	}
+
+	if got := h.neighbors(cellCoord{X: 2, Y: 2}); !reflect.DeepEqual(got, []int{3}) {
+		t.Errorf("neighbors(2,2) = %v, want [3]", got)
+	}
+}
+
+func TestSpatialHashRebuildClearsStaleCells(t *testing.T) {
+	h := newSpatialHash(10)
+	h.rebuild([]Position{{X: 1, Y: 1}, {X: 21, Y: 21}})
+
+	// Rebuilding with fewer, relocated positions must not leave index 1
+	// behind in its old cell (2,2).
+	h.rebuild([]Position{{X: 1, Y: 1}})
+
+	if got := h.neighbors(cellCoord{X: 2, Y: 2}); len(got) != 0 {
+		t.Errorf("neighbors(2,2) = %v, want empty after rebuild dropped that entity", got)
+	}
+	if got := h.neighbors(cellCoord{X: 0, Y: 0}); !reflect.DeepEqual(got, []int{0}) {
+		t.Errorf("neighbors(0,0) = %v, want [0]", got)
+	}
+}
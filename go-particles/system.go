@@ -0,0 +1,13 @@
+package main
+
+import "github.com/hajimehoshi/ebiten/v2"
+
+// System operates on a World once per tick. Registering a System with
+// World.Systems is the only thing new behavior (emitters, force fields,
+// collision handlers) needs to do; the core loop never changes.
+//
+// Systems that don't render anything are free to leave Draw empty.
+type System interface {
+	Update(w *World, dt float64)
+	Draw(w *World, screen *ebiten.Image)
+}
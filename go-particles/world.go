@@ -0,0 +1,165 @@
+package main
+
+import (
+	"sort"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Entity identifies a row across the World's parallel component slices.
+type Entity int
+
+// World holds every entity's components as parallel slices indexed by
+// Entity, plus sparse maps for components most entities don't carry.
+// Systems operate on the World instead of a single hard-coded struct, so
+// new behavior (emitters, force fields, collision handlers) can be added
+// by registering another System rather than editing the core loop.
+type World struct {
+	Width, Height float64
+	DeltaTime     float64
+
+	Positions  []Position
+	Velocities []Velocity
+	Masses     []Mass
+	Colors     []Color
+
+	Lifetimes map[Entity]*Lifetime
+	Sprites   map[Entity]*Sprite
+	Forces    map[Entity]*Force
+
+	Systems []System
+
+	// History, when set, records snapshots for Rewind. Paused freezes
+	// every system's Update while the caller is scrubbing backward.
+	History *History
+
+	// Physics, when set by UsePhysics, is kept in sync with
+	// NewEntity/RemoveEntity so its cp.Body map never desyncs from the
+	// Entity indices the rest of World uses.
+	Physics *PhysicsSystem
+
+	Paused bool
+}
+
+// NewWorld creates an empty world with no entities or systems.
+func NewWorld(width, height float64) *World {
+	return &World{
+		Width:     width,
+		Height:    height,
+		DeltaTime: 1.0 / 60.0, // 60 FPS
+		Lifetimes: make(map[Entity]*Lifetime),
+		Sprites:   make(map[Entity]*Sprite),
+		Forces:    make(map[Entity]*Force),
+	}
+}
+
+// NewEntity appends a row to the dense component slices and returns its
+// Entity id. Optional components (Lifetime, Sprite, Force) are attached
+// afterward by setting the corresponding map entry.
+func (w *World) NewEntity(pos Position, vel Velocity, mass Mass, col Color) Entity {
+	e := Entity(len(w.Positions))
+	w.Positions = append(w.Positions, pos)
+	w.Velocities = append(w.Velocities, vel)
+	w.Masses = append(w.Masses, mass)
+	w.Colors = append(w.Colors, col)
+
+	if w.Physics != nil {
+		w.Physics.onEntityCreated(w, e)
+	}
+	if w.History != nil {
+		w.History.invalidate(e)
+	}
+
+	return e
+}
+
+// RemoveEntity retires an entity by swapping it with the last row of
+// every component slice and shrinking by one, so indices stay dense
+// without shifting the whole slice down. Any optional components attached
+// to the swapped-in entity move with it.
+//
+// Safe to call once per tick. Calling it more than once in the same tick
+// requires removing in strictly descending Entity order, since each call
+// renumbers whatever previously held the last index — use
+// RemoveEntities, which handles the ordering, whenever a system can
+// retire more than one entity in the same Update.
+func (w *World) RemoveEntity(e Entity) {
+	if w.Physics != nil {
+		w.Physics.onEntityRemoved(e)
+	}
+
+	last := Entity(len(w.Positions) - 1)
+	if e != last {
+		w.Positions[e] = w.Positions[last]
+		w.Velocities[e] = w.Velocities[last]
+		w.Masses[e] = w.Masses[last]
+		w.Colors[e] = w.Colors[last]
+
+		if lt, ok := w.Lifetimes[last]; ok {
+			w.Lifetimes[e] = lt
+		} else {
+			delete(w.Lifetimes, e)
+		}
+		if sp, ok := w.Sprites[last]; ok {
+			w.Sprites[e] = sp
+		} else {
+			delete(w.Sprites, e)
+		}
+		if f, ok := w.Forces[last]; ok {
+			w.Forces[e] = f
+		} else {
+			delete(w.Forces, e)
+		}
+
+		if w.Physics != nil {
+			w.Physics.onEntityMoved(last, e)
+		}
+		if w.History != nil {
+			w.History.invalidate(e)
+		}
+	}
+
+	w.Positions = w.Positions[:last]
+	w.Velocities = w.Velocities[:last]
+	w.Masses = w.Masses[:last]
+	w.Colors = w.Colors[:last]
+	delete(w.Lifetimes, last)
+	delete(w.Sprites, last)
+	delete(w.Forces, last)
+}
+
+// RemoveEntities retires every entity in ids, which may be given in any
+// order and is not modified. It sorts a copy in descending order first
+// so that each swap-with-last in RemoveEntity only ever touches indices
+// not yet processed, regardless of the order entities died in.
+func (w *World) RemoveEntities(ids []Entity) {
+	sorted := append([]Entity(nil), ids...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] > sorted[j] })
+	for _, e := range sorted {
+		w.RemoveEntity(e)
+	}
+}
+
+// Update advances every registered system by one tick, then records a
+// History snapshot if one is attached. Forward integration is skipped
+// entirely while Paused, e.g. during a Rewind scrub.
+func (w *World) Update(dt float64) {
+	if w.Paused {
+		return
+	}
+
+	for _, sys := range w.Systems {
+		sys.Update(w, dt)
+	}
+
+	if w.History != nil {
+		w.History.Record(w)
+	}
+}
+
+// Draw lets every registered system render its entities.
+func (w *World) Draw(screen *ebiten.Image) {
+	for _, sys := range w.Systems {
+		sys.Draw(w, screen)
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"image/color"
+	"testing"
+)
+
+func newTestWorld(n int) *World {
+	w := NewWorld(100, 100)
+	for i := 0; i < n; i++ {
+		w.NewEntity(
+			Position{X: float64(i), Y: float64(i)},
+			Velocity{},
+			Mass{Value: 1},
+			Color{color.RGBA{}},
+		)
+	}
+	return w
+}
+
+func alivePositions(w *World) map[float64]bool {
+	alive := make(map[float64]bool, len(w.Positions))
+	for _, p := range w.Positions {
+		alive[p.X] = true
+	}
+	return alive
+}
+
+// TestRemoveEntitiesOrderIndependent is a regression test for the bug
+// chunk0-4's LifetimeSystem used to hit: calling RemoveEntity once per dead
+// entity in map-range order (effectively random) renumbers whichever entity
+// the previous call swapped into the removed slot, so ascending input order
+// can remove the wrong row or panic. RemoveEntities must retire the same
+// set of entities no matter what order its ids arrive in.
+func TestRemoveEntitiesOrderIndependent(t *testing.T) {
+	tests := []struct {
+		name string
+		ids  []Entity
+	}{
+		{"ascending", []Entity{5, 9}},
+		{"descending", []Entity{9, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := newTestWorld(10)
+			w.RemoveEntities(tt.ids)
+
+			if len(w.Positions) != 8 {
+				t.Fatalf("len(Positions) = %d, want 8", len(w.Positions))
+			}
+
+			alive := alivePositions(w)
+			for _, dead := range []float64{5, 9} {
+				if alive[dead] {
+					t.Errorf("entity %v still present after removal", dead)
+				}
+			}
+			if len(alive) != 8 {
+				t.Errorf("got %d distinct surviving entities, want 8 (duplicates from a bad swap?)", len(alive))
+			}
+		})
+	}
+}